@@ -0,0 +1,213 @@
+package async
+
+import (
+	"context"
+	"sync"
+)
+
+// Awaitable is the minimal surface the combinators in this file rely on.
+// *Fetcher[T, A] implements it for any A, so Fetchers that only differ in
+// argument type can be combined together as a single []Awaitable[T].
+// Result is included (not just Await) so the combinators can preserve
+// Attempts when composing retried Fetchers.
+type Awaitable[T any] interface {
+	Await() (T, error)
+	Done() <-chan struct{}
+	Result() Result[T]
+}
+
+// AwaitAll waits for every fetcher to complete and returns their results
+// in the same order as fetchers. It returns as soon as any fetcher
+// reports an error, without waiting for the rest. Because fetchers have
+// already been started (Awaitable has no Run hook), this only stops
+// AwaitAll's own wait early — the other fetchers' underlying computations
+// keep running to completion regardless.
+func AwaitAll[T any](ctx context.Context, fetchers ...Awaitable[T]) ([]Result[T], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	n := len(fetchers)
+	results := make([]Result[T], n)
+	errCh := make(chan error, 1)
+	doneCh := make(chan struct{}, n)
+
+	for i, f := range fetchers {
+		i, f := i, f
+		go func() {
+			res := f.Result()
+			results[i] = res
+			if res.Err != nil {
+				select {
+				case errCh <- res.Err:
+					cancel()
+				default:
+				}
+			}
+			doneCh <- struct{}{}
+		}()
+	}
+
+	for completed := 0; completed < n; {
+		select {
+		case err := <-errCh:
+			return results, err
+		default:
+		}
+
+		select {
+		case err := <-errCh:
+			return results, err
+		case <-doneCh:
+			completed++
+		case <-ctx.Done():
+			return results, firstNonNil(drainErr(errCh), ctx.Err())
+		}
+	}
+
+	return results, nil
+}
+
+// drainErr does a non-blocking read of errCh, returning nil if nothing is
+// pending.
+func drainErr(errCh <-chan error) error {
+	select {
+	case err := <-errCh:
+		return err
+	default:
+		return nil
+	}
+}
+
+func firstNonNil(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AwaitAny returns the result and index of the first fetcher to complete
+// successfully. If every fetcher errors, it returns the last error
+// observed.
+func AwaitAny[T any](ctx context.Context, fetchers ...Awaitable[T]) (Result[T], int, error) {
+	type outcome struct {
+		idx int
+		res Result[T]
+	}
+
+	n := len(fetchers)
+	ch := make(chan outcome, n)
+	for i, f := range fetchers {
+		i, f := i, f
+		go func() {
+			ch <- outcome{idx: i, res: f.Result()}
+		}()
+	}
+
+	var lastErr error
+	for i := 0; i < n; i++ {
+		select {
+		case o := <-ch:
+			if o.res.Err == nil {
+				return o.res, o.idx, nil
+			}
+			lastErr = o.res.Err
+		case <-ctx.Done():
+			return Result[T]{}, -1, ctx.Err()
+		}
+	}
+
+	return Result[T]{}, -1, lastErr
+}
+
+// AwaitSettled waits for every fetcher to complete and returns their
+// results in order, regardless of whether any of them errored. It stops
+// waiting early and returns the results gathered so far if ctx is
+// canceled first.
+func AwaitSettled[T any](ctx context.Context, fetchers ...Awaitable[T]) []Result[T] {
+	results := make([]Result[T], len(fetchers))
+
+	done := make(chan struct{})
+	go func() {
+		var wg sync.WaitGroup
+		wg.Add(len(fetchers))
+		for i, f := range fetchers {
+			i, f := i, f
+			go func() {
+				defer wg.Done()
+				results[i] = f.Result()
+			}()
+		}
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+	}
+
+	return results
+}
+
+// AwaitAllN behaves like AwaitAll but only lets n fetchers run
+// concurrently, starting each one's Run only once a slot frees up.
+// Because it starts the fetchers itself, it needs the concrete
+// Fetcher[T, A] rather than the heterogeneous Awaitable[T] interface.
+func AwaitAllN[T any, A any](ctx context.Context, n int, fetchers ...*Fetcher[T, A]) ([]Result[T], error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]Result[T], len(fetchers))
+	sem := make(chan struct{}, n)
+	errCh := make(chan error, 1)
+	var wg sync.WaitGroup
+
+	for i, f := range fetchers {
+		i, f := i, f
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			return results, firstNonNil(drainErr(errCh), ctx.Err())
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			res := f.Run(ctx).Result()
+			results[i] = res
+			if res.Err != nil {
+				select {
+				case errCh <- res.Err:
+					cancel()
+				default:
+				}
+			}
+		}()
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case err := <-errCh:
+		return results, err
+	default:
+	}
+
+	select {
+	case err := <-errCh:
+		return results, err
+	case <-done:
+		return results, nil
+	case <-ctx.Done():
+		return results, firstNonNil(drainErr(errCh), ctx.Err())
+	}
+}
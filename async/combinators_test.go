@@ -0,0 +1,188 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAwaitAll_Success(t *testing.T) {
+	ctx := context.Background()
+	f1 := Async(fetchUser, 1).Run(ctx)
+	f2 := Async(fetchUser, 2).Run(ctx)
+	f3 := Async(fetchUser, 3).Run(ctx)
+
+	results, err := AwaitAll[string](ctx, f1, f2, f3)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	want := []string{"user-1", "user-2", "user-3"}
+	for i, r := range results {
+		if r.Value != want[i] {
+			t.Errorf("result %d: expected %v, got %v", i, want[i], r.Value)
+		}
+	}
+}
+
+func TestAwaitAll_ShortCircuitsOnError(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("boom")
+	slow := Async(func(ctx context.Context, id int) (string, error) {
+		time.Sleep(200 * time.Millisecond)
+		return "slow", nil
+	}, 1).Run(ctx)
+	fast := Async(func(ctx context.Context, id int) (string, error) {
+		return "", boom
+	}, 1).Run(ctx)
+
+	start := time.Now()
+	_, err := AwaitAll[string](ctx, slow, fast)
+	if !errors.Is(err, boom) {
+		t.Fatalf("expected boom error, got %v", err)
+	}
+	if time.Since(start) >= 100*time.Millisecond {
+		t.Error("expected AwaitAll to return as soon as the error fetcher settled")
+	}
+}
+
+func TestAwaitAny_ReturnsFirstSuccess(t *testing.T) {
+	ctx := context.Background()
+	f1 := Async(fetchUser, -1).Run(ctx)
+	f2 := Async(fetchUser, 2).Run(ctx)
+
+	res, idx, err := AwaitAny[string](ctx, f1, f2)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if idx != 1 || res.Value != "user-2" {
+		t.Errorf("expected index 1 / 'user-2', got index %d / %v", idx, res.Value)
+	}
+}
+
+func TestAwaitAny_AllErrorsReturnsLastError(t *testing.T) {
+	ctx := context.Background()
+	f1 := Async(fetchUser, -1).Run(ctx)
+	f2 := Async(fetchUser, -2).Run(ctx)
+
+	_, idx, err := AwaitAny[string](ctx, f1, f2)
+	if err == nil {
+		t.Error("expected an error when every fetcher fails")
+	}
+	if idx != -1 {
+		t.Errorf("expected index -1, got %d", idx)
+	}
+}
+
+func TestAwaitSettled_WaitsForAllRegardlessOfError(t *testing.T) {
+	ctx := context.Background()
+	f1 := Async(fetchUser, 1).Run(ctx)
+	f2 := Async(fetchUser, -1).Run(ctx)
+
+	results := AwaitSettled[string](ctx, f1, f2)
+	if len(results) != 2 {
+		t.Fatalf("expected 2 results, got %d", len(results))
+	}
+	if results[0].Err != nil || results[0].Value != "user-1" {
+		t.Errorf("expected first result to succeed, got %+v", results[0])
+	}
+	if results[1].Err == nil {
+		t.Errorf("expected second result to carry its error")
+	}
+}
+
+func TestAwaitAllN_BoundsConcurrency(t *testing.T) {
+	ctx := context.Background()
+	var running, maxRunning int32
+	slow := func(ctx context.Context, id int) (int, error) {
+		cur := atomic.AddInt32(&running, 1)
+		for {
+			prev := atomic.LoadInt32(&maxRunning)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxRunning, prev, cur) {
+				break
+			}
+		}
+		time.Sleep(30 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return id, nil
+	}
+
+	fetchers := make([]*Fetcher[int, int], 6)
+	for i := range fetchers {
+		fetchers[i] = Async(slow, i)
+	}
+
+	results, err := AwaitAllN[int, int](ctx, 2, fetchers...)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if len(results) != 6 {
+		t.Fatalf("expected 6 results, got %d", len(results))
+	}
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent fetchers, observed %d", maxRunning)
+	}
+}
+
+func TestAwaitAllN_PropagatesError(t *testing.T) {
+	ctx := context.Background()
+	boom := errors.New("boom")
+	f1 := Async(func(ctx context.Context, id int) (int, error) { return 0, boom }, 1)
+	f2 := Async(func(ctx context.Context, id int) (int, error) {
+		time.Sleep(50 * time.Millisecond)
+		return id, nil
+	}, 2)
+
+	_, err := AwaitAllN[int, int](ctx, 1, f1, f2)
+	if !errors.Is(err, boom) {
+		t.Errorf("expected boom error, got %v", err)
+	}
+}
+
+func TestAwaitAllN_PreservesAttempts(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		c := atomic.AddInt32(&calls, 1)
+		if c < 3 {
+			return 0, errors.New("transient")
+		}
+		return n, nil
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+	})
+
+	results, err := AwaitAllN[int, int](ctx, 1, f)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if results[0].Attempts != 3 {
+		t.Errorf("expected Result.Attempts to be 3, got %d", results[0].Attempts)
+	}
+}
+
+func TestAwaitSettled_PreservesAttempts(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		c := atomic.AddInt32(&calls, 1)
+		if c < 2 {
+			return 0, errors.New("transient")
+		}
+		return n, nil
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+	}).Run(ctx)
+
+	results := AwaitSettled[int](ctx, f)
+	if results[0].Attempts != 2 {
+		t.Errorf("expected Result.Attempts to be 2, got %d", results[0].Attempts)
+	}
+}
@@ -0,0 +1,67 @@
+package async
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrExecutorClosed is returned by submissions made after Close.
+var ErrExecutorClosed = errors.New("async: executor is closed")
+
+// Executor bounds how many Fetchers may run concurrently, so a service
+// that starts many Fetchers per request doesn't spawn an unbounded number
+// of goroutines. Pair a Fetcher with an Executor via Fetcher.RunOn.
+type Executor struct {
+	sem chan struct{}
+	wg  sync.WaitGroup
+
+	mu     sync.Mutex
+	closed bool
+}
+
+// NewExecutor creates an Executor that runs at most maxWorkers submitted
+// tasks concurrently; the rest queue until a slot frees up. maxWorkers
+// values less than 1 are clamped to 1, since a zero-capacity semaphore
+// would deadlock the first submission.
+func NewExecutor(maxWorkers int) *Executor {
+	if maxWorkers < 1 {
+		maxWorkers = 1
+	}
+	return &Executor{sem: make(chan struct{}, maxWorkers)}
+}
+
+// submit queues fn to run on the pool, blocking the caller until a worker
+// slot is free. It returns ErrExecutorClosed without running fn if Close
+// has already been called.
+func (e *Executor) submit(fn func()) error {
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return ErrExecutorClosed
+	}
+	e.wg.Add(1)
+	e.mu.Unlock()
+
+	e.sem <- struct{}{}
+	go func() {
+		defer e.wg.Done()
+		defer func() { <-e.sem }()
+		fn()
+	}()
+
+	return nil
+}
+
+// Wait blocks until every task submitted so far has completed.
+func (e *Executor) Wait() {
+	e.wg.Wait()
+}
+
+// Close stops the Executor from accepting new submissions. Tasks already
+// running or queued continue to completion; call Wait afterwards to block
+// until they finish.
+func (e *Executor) Close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.closed = true
+}
@@ -0,0 +1,114 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestExecutor_ZeroOrNegativeWorkersClampToOne(t *testing.T) {
+	ctx := context.Background()
+	for _, n := range []int{0, -1} {
+		e := NewExecutor(n)
+		fn := func(ctx context.Context, id int) (int, error) { return id, nil }
+		f := Async(fn, 1).RunOn(ctx, e)
+
+		val, err := f.Await()
+		if err != nil {
+			t.Fatalf("NewExecutor(%d): expected no error, got %v", n, err)
+		}
+		if val != 1 {
+			t.Errorf("NewExecutor(%d): expected 1, got %v", n, val)
+		}
+	}
+}
+
+func TestExecutor_BoundsConcurrency(t *testing.T) {
+	ctx := context.Background()
+	e := NewExecutor(2)
+
+	var running, maxRunning int32
+	slow := func(ctx context.Context, id int) (int, error) {
+		cur := atomic.AddInt32(&running, 1)
+		for {
+			prev := atomic.LoadInt32(&maxRunning)
+			if cur <= prev || atomic.CompareAndSwapInt32(&maxRunning, prev, cur) {
+				break
+			}
+		}
+		time.Sleep(30 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		return id, nil
+	}
+
+	fetchers := make([]*Fetcher[int, int], 6)
+	for i := range fetchers {
+		fetchers[i] = Async(slow, i).RunOn(ctx, e)
+	}
+
+	for _, f := range fetchers {
+		if _, err := f.Await(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	}
+
+	if maxRunning > 2 {
+		t.Errorf("expected at most 2 concurrent fetchers, observed %d", maxRunning)
+	}
+}
+
+func TestExecutor_WaitBlocksUntilAllTasksComplete(t *testing.T) {
+	ctx := context.Background()
+	e := NewExecutor(3)
+
+	var completed int32
+	fn := func(ctx context.Context, id int) (int, error) {
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&completed, 1)
+		return id, nil
+	}
+
+	for i := 0; i < 5; i++ {
+		Async(fn, i).RunOn(ctx, e)
+	}
+
+	e.Wait()
+	if completed != 5 {
+		t.Errorf("expected all 5 tasks to complete before Wait returns, got %d", completed)
+	}
+}
+
+func TestExecutor_CloseRejectsNewSubmissions(t *testing.T) {
+	ctx := context.Background()
+	e := NewExecutor(1)
+	e.Close()
+
+	fn := func(ctx context.Context, id int) (int, error) { return id, nil }
+	f := Async(fn, 1).RunOn(ctx, e)
+
+	_, err := f.Await()
+	if !errors.Is(err, ErrExecutorClosed) {
+		t.Errorf("expected ErrExecutorClosed, got %v", err)
+	}
+}
+
+func TestExecutor_PreservesPanicRecovery(t *testing.T) {
+	ctx := context.Background()
+	e := NewExecutor(1)
+
+	panicFn := func(ctx context.Context, id int) (int, error) {
+		panic("boom")
+	}
+	f := Async(panicFn, 1).RunOn(ctx, e)
+
+	_, err := f.Await()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *PanicError, got %T", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("expected recovered value 'boom', got %v", pe.Value)
+	}
+}
@@ -6,18 +6,19 @@ import (
 	"log"
 	"runtime/debug"
 	"sync"
+	"time"
 )
 
 // Package async provides utilities for running asynchronous computations
 
 var (
-	globalPanicHandlers []func(context.Context, interface{})
+	globalPanicHandlers []func(context.Context, *PanicError)
 	handlersMu          sync.RWMutex
 )
 
 // SetPanicHandlers sets the global panic handlers to be used by all Fetchers.
 // This should be called once during service initialization.
-func SetPanicHandlers(handlers ...func(context.Context, interface{})) {
+func SetPanicHandlers(handlers ...func(context.Context, *PanicError)) {
 	handlersMu.Lock()
 	defer handlersMu.Unlock()
 	globalPanicHandlers = handlers
@@ -26,6 +27,37 @@ func SetPanicHandlers(handlers ...func(context.Context, interface{})) {
 type Result[T any] struct {
 	Value T
 	Err   error
+
+	// Attempts is the number of times Fn was invoked. It is 1 unless a
+	// RetryPolicy was attached via WithRetry, in which case it reflects
+	// how many attempts the retry loop actually made. It is 0 if Fn never
+	// ran (ctx was already done when the computation started, or RunOn's
+	// Executor was closed).
+	Attempts int
+}
+
+// PanicError wraps a value recovered from a panic inside a Fetcher's Fn,
+// together with the stack trace captured at the point of recovery. Goexit
+// is set when the goroutine unwound via runtime.Goexit (e.g. t.FailNow in
+// a test helper) rather than an actual panic, in which case Value is nil.
+type PanicError struct {
+	Value  interface{}
+	Stack  []byte
+	Goexit bool
+}
+
+func (e *PanicError) Error() string {
+	if e.Goexit {
+		return "async: runtime.Goexit called inside Fetcher.Fn"
+	}
+	return fmt.Sprintf("async: panic recovered: %v", e.Value)
+}
+
+// Unwrap returns the recovered value as an error when it already is one,
+// so callers can use errors.As/errors.Is against the original cause.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
 }
 
 // Fetcher represents a one-time asynchronous computation whose result
@@ -46,6 +78,9 @@ type Fetcher[T any, A any] struct {
 	Arg    A
 	result Result[T]
 	ch     *chan struct{}
+
+	timeout time.Duration
+	retry   *RetryPolicy
 }
 
 // Async creates a new Fetcher for the provided function and argument.
@@ -57,6 +92,24 @@ func Async[T any, A any](fn func(context.Context, A) (T, error), arg A) *Fetcher
 	}
 }
 
+// WithTimeout attaches a per-attempt timeout: each call to Fn runs under
+// a context.WithTimeout(ctx, d) derived from the ctx passed to Run. This
+// applies whether or not WithRetry is also used; without WithRetry, Fn
+// still gets exactly one attempt under the timeout. Must be called before
+// Run/RunOn. Returns the Fetcher for chaining.
+func (f *Fetcher[T, A]) WithTimeout(d time.Duration) *Fetcher[T, A] {
+	f.timeout = d
+	return f
+}
+
+// WithRetry attaches a RetryPolicy so Run/RunOn retries a failing
+// attempt instead of returning its error immediately. Must be called
+// before Run/RunOn. Returns the Fetcher for chaining.
+func (f *Fetcher[T, A]) WithRetry(p RetryPolicy) *Fetcher[T, A] {
+	f.retry = &p
+	return f
+}
+
 // Run starts the asynchronous computation in a new goroutine.
 // If called multiple times, only the first call starts the computation.
 // Returns the Fetcher itself for chaining.
@@ -66,36 +119,80 @@ func (f *Fetcher[T, A]) Run(ctx context.Context) *Fetcher[T, A] {
 	}
 
 	f.ch = ToPtr(make(chan struct{}))
-	go func() {
-		defer func() {
-			if r := recover(); r != nil {
-				handlePanicErr(ctx, r)
-				f.result.Err = fmt.Errorf("[Fetcher.Async]: panic recovered: %v", r)
-			}
+	go f.compute(ctx)
 
-			close(*f.ch)
-		}()
+	return f
+}
 
-		select {
-		case <-ctx.Done():
-			var zero T
-			f.result = Result[T]{Value: zero, Err: ctx.Err()}
-			return
-		default:
-			// Proceed with computation.
-		}
+// RunOn behaves like Run but executes the computation on e's bounded
+// worker pool instead of spawning an unconstrained goroutine, so at most
+// e's maxWorkers Fetchers run at once. If called multiple times, only the
+// first call starts the computation. Returns the Fetcher itself for
+// chaining.
+func (f *Fetcher[T, A]) RunOn(ctx context.Context, e *Executor) *Fetcher[T, A] {
+	if f.ch != nil {
+		return f
+	}
+
+	f.ch = ToPtr(make(chan struct{}))
+	if err := e.submit(func() { f.compute(ctx) }); err != nil {
+		// Rejected submission: Fn never runs and the Fetcher completes
+		// with ErrExecutorClosed.
+		f.result.Err = err
+		close(*f.ch)
+	}
 
-		val, err := f.Fn(ctx, f.Arg)
+	return f
+}
 
-		// Check again in case f.Fn is context-aware and returns quickly on cancel
-		if ctx.Err() != nil && err == nil {
-			err = ctx.Err()
+// compute runs Fn with the current panic/Goexit recovery and context
+// cancellation semantics, then closes f.ch. It is shared by Run and
+// RunOn, which differ only in how the goroutine running it is scheduled.
+func (f *Fetcher[T, A]) compute(ctx context.Context) {
+	normalReturn := false
+	defer func() {
+		r := recover()
+		if normalReturn && r == nil {
+			close(*f.ch)
+			return
 		}
 
-		f.result = Result[T]{Value: val, Err: err}
+		pe := &PanicError{
+			Value:  r,
+			Stack:  debug.Stack(),
+			Goexit: !normalReturn && r == nil,
+		}
+		handlePanicErr(ctx, pe)
+		f.result.Err = pe
+		close(*f.ch)
 	}()
 
-	return f
+	select {
+	case <-ctx.Done():
+		var zero T
+		f.result = Result[T]{Value: zero, Err: ctx.Err()}
+		normalReturn = true
+		return
+	default:
+		// Proceed with computation.
+	}
+
+	if f.retry != nil || f.timeout > 0 {
+		f.result = f.runWithRetry(ctx)
+		normalReturn = true
+		return
+	}
+
+	f.result.Attempts = 1 // kept if Fn panics
+	val, err := f.Fn(ctx, f.Arg)
+
+	// Check again in case f.Fn is context-aware and returns quickly on cancel
+	if ctx.Err() != nil && err == nil {
+		err = ctx.Err()
+	}
+
+	f.result = Result[T]{Value: val, Err: err, Attempts: 1}
+	normalReturn = true
 }
 
 // Await blocks until the computation is complete and returns the result and error.
@@ -109,13 +206,74 @@ func (f *Fetcher[T, A]) Await() (T, error) {
 	return f.result.Value, f.result.Err
 }
 
+// Result blocks until the computation completes and returns the full
+// Result, including Attempts — unlike Await, which only returns the value
+// and error. Panics if called before Run.
+func (f *Fetcher[T, A]) Result() Result[T] {
+	if f.ch == nil {
+		panic("fetcher not started, call Run() first")
+	}
+
+	<-*f.ch
+	return f.result
+}
+
+// Done returns a channel that is closed once the computation completes,
+// so callers can select on it alongside their own ctx/timers. Panics if
+// called before Run.
+func (f *Fetcher[T, A]) Done() <-chan struct{} {
+	if f.ch == nil {
+		panic("fetcher not started, call Run() first")
+	}
+	return *f.ch
+}
+
+// Peek returns the result without blocking. The third return value is
+// false if the computation has not completed yet (or Run has not been
+// called), in which case Value and Err are the zero value.
+func (f *Fetcher[T, A]) Peek() (T, error, bool) {
+	if f.ch == nil {
+		var zero T
+		return zero, nil, false
+	}
+
+	select {
+	case <-*f.ch:
+		return f.result.Value, f.result.Err, true
+	default:
+		var zero T
+		return zero, nil, false
+	}
+}
+
+// AwaitContext blocks until the computation completes or ctx is done,
+// whichever happens first, returning ctx.Err() in the latter case.
+// Unlike the ctx passed to Run, canceling ctx here does not cancel the
+// underlying computation — it only stops this call from waiting on it.
+func (f *Fetcher[T, A]) AwaitContext(ctx context.Context) (T, error) {
+	if f.ch == nil {
+		panic("fetcher not started, call Run() first")
+	}
+
+	select {
+	case <-*f.ch:
+		return f.result.Value, f.result.Err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
 func handlePanicErr(
 	ctx context.Context,
-	r interface{},
+	pe *PanicError,
 ) {
-	log.Printf("[ERROR] Panic recovered - %+v\n%s", r, string(debug.Stack()))
+	log.Printf("[ERROR] Panic recovered - %+v\n%s", pe.Value, string(pe.Stack))
+
+	handlersMu.RLock()
+	defer handlersMu.RUnlock()
 	for _, fn := range globalPanicHandlers {
-		fn(ctx, r)
+		fn(ctx, pe)
 	}
 }
 
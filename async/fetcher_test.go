@@ -127,11 +127,11 @@ func TestMultipleFetchers_RunInParallel(t *testing.T) {
 func TestFetcher_PanicHandlerCalled(t *testing.T) {
 	ctx := context.Background()
 	handlerCalled := false
-	var recoveredValue interface{}
+	var recoveredErr *PanicError
 
-	SetPanicHandlers(func(c context.Context, r interface{}) {
+	SetPanicHandlers(func(c context.Context, pe *PanicError) {
 		handlerCalled = true
-		recoveredValue = r
+		recoveredErr = pe
 	})
 
 	panicFn := func(ctx context.Context, a int) (string, error) {
@@ -142,8 +142,14 @@ func TestFetcher_PanicHandlerCalled(t *testing.T) {
 	if !handlerCalled {
 		t.Error("expected panic handler to be called")
 	}
-	if recoveredValue != "test-panic" {
-		t.Errorf("expected recovered value to be 'test-panic', got %v", recoveredValue)
+	if recoveredErr == nil || recoveredErr.Value != "test-panic" {
+		t.Errorf("expected recovered value to be 'test-panic', got %v", recoveredErr)
+	}
+	if len(recoveredErr.Stack) == 0 {
+		t.Error("expected recovered PanicError to capture a stack trace")
+	}
+	if recoveredErr.Goexit {
+		t.Error("expected Goexit to be false for a real panic")
 	}
 	if err == nil || err.Error() == "" {
 		t.Error("expected error from panic, got nil or empty error")
@@ -153,6 +159,92 @@ func TestFetcher_PanicHandlerCalled(t *testing.T) {
 	SetPanicHandlers()
 }
 
+func TestFetcher_PanicError_Unwrap(t *testing.T) {
+	ctx := context.Background()
+	cause := errors.New("root cause")
+	panicFn := func(ctx context.Context, a int) (string, error) {
+		panic(cause)
+	}
+	f := Async(panicFn, 1).Run(ctx)
+	_, err := f.Await()
+
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected err to be a *PanicError, got %T", err)
+	}
+	if !errors.Is(pe, cause) {
+		t.Errorf("expected PanicError to unwrap to the recovered error")
+	}
+}
+
+func TestFetcher_PeekBeforeAndAfterCompletion(t *testing.T) {
+	ctx := context.Background()
+	f := Async(fetchUser, 9).Run(ctx)
+
+	if _, _, ok := f.Peek(); ok {
+		t.Error("expected Peek to report not-ready before completion")
+	}
+
+	val, err := f.Await()
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if val != "user-9" {
+		t.Fatalf("expected 'user-9', got %v", val)
+	}
+
+	peekVal, peekErr, ok := f.Peek()
+	if !ok {
+		t.Error("expected Peek to report ready after completion")
+	}
+	if peekErr != nil || peekVal != "user-9" {
+		t.Errorf("expected Peek to return ('user-9', nil), got (%v, %v)", peekVal, peekErr)
+	}
+}
+
+func TestFetcher_Done_ClosesOnCompletion(t *testing.T) {
+	ctx := context.Background()
+	f := Async(fetchUser, 4).Run(ctx)
+
+	select {
+	case <-f.Done():
+		t.Error("expected Done channel to still be open immediately after Run")
+	default:
+	}
+
+	<-f.Done()
+	if _, _, ok := f.Peek(); !ok {
+		t.Error("expected the result to be ready once Done is closed")
+	}
+}
+
+func TestFetcher_AwaitContext_ReturnsEarlyOnCancel(t *testing.T) {
+	ctx := context.Background()
+	slow := func(ctx context.Context, id int) (string, error) {
+		time.Sleep(200 * time.Millisecond)
+		return "done", nil
+	}
+	f := Async(slow, 1).Run(ctx)
+
+	callerCtx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := f.AwaitContext(callerCtx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) >= 100*time.Millisecond {
+		t.Error("expected AwaitContext to return as soon as the caller's context expired")
+	}
+
+	// The underlying computation keeps running and still completes normally.
+	val, err := f.Await()
+	if err != nil || val != "done" {
+		t.Errorf("expected the original computation to finish, got (%v, %v)", val, err)
+	}
+}
+
 // contains checks if substr is in s
 func contains(s, substr string) bool {
 	return len(substr) == 0 || (len(s) >= len(substr) && (s == substr || len(s) > len(substr) && (contains(s[1:], substr) || contains(s[:len(s)-1], substr))))
@@ -0,0 +1,65 @@
+package async
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Group deduplicates concurrent calls that share the same key, much like
+// the singleflight pattern, but built on top of Fetcher so callers keep
+// the usual Run/Await ergonomics. The first caller for a key starts the
+// computation; any caller that arrives while it is still in flight waits
+// on the same Fetcher instead of starting a duplicate one.
+type Group[K comparable, T any, A any] struct {
+	mu          sync.Mutex
+	inflight    map[K]*Fetcher[T, A]
+	forgetAfter time.Duration
+}
+
+// NewGroup creates a Group. forgetAfter controls how long a completed
+// entry is kept around before it is removed from the in-flight map; a
+// value <= 0 removes the entry as soon as the computation finishes, so a
+// later Do for the same key always starts fresh.
+func NewGroup[K comparable, T any, A any](forgetAfter time.Duration) *Group[K, T, A] {
+	return &Group[K, T, A]{
+		inflight:    make(map[K]*Fetcher[T, A]),
+		forgetAfter: forgetAfter,
+	}
+}
+
+// Do runs fn(ctx, arg) for key, unless a computation for key is already in
+// flight, in which case it awaits that existing Fetcher instead. The
+// returned bool reports whether the result was shared with another
+// caller rather than computed by this call.
+func (g *Group[K, T, A]) Do(ctx context.Context, key K, fn func(context.Context, A) (T, error), arg A) (Result[T], bool) {
+	g.mu.Lock()
+	if f, ok := g.inflight[key]; ok {
+		g.mu.Unlock()
+		return f.Result(), true
+	}
+
+	f := Async(fn, arg)
+	f.Run(ctx)
+	g.inflight[key] = f
+	g.mu.Unlock()
+
+	res := f.Result()
+
+	if g.forgetAfter <= 0 {
+		g.Forget(key)
+	} else {
+		time.AfterFunc(g.forgetAfter, func() { g.Forget(key) })
+	}
+
+	return res, false
+}
+
+// Forget removes key from the in-flight map, so the next Do call for key
+// starts a new computation instead of joining one that is already running
+// or has already completed.
+func (g *Group[K, T, A]) Forget(key K) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.inflight, key)
+}
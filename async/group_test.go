@@ -0,0 +1,99 @@
+package async
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup_DeduplicatesConcurrentCalls(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, string, int](0)
+
+	var calls int32
+	fn := func(ctx context.Context, id int) (string, error) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		return fmt.Sprintf("user-%d", id), nil
+	}
+
+	type outcome struct {
+		res    Result[string]
+		shared bool
+	}
+	results := make(chan outcome, 5)
+	for i := 0; i < 5; i++ {
+		go func() {
+			res, shared := g.Do(ctx, "user-1", fn, 1)
+			results <- outcome{res, shared}
+		}()
+	}
+
+	sharedCount := 0
+	for i := 0; i < 5; i++ {
+		o := <-results
+		if o.res.Err != nil {
+			t.Errorf("expected no error, got %v", o.res.Err)
+		}
+		if o.res.Value != "user-1" {
+			t.Errorf("expected 'user-1', got %v", o.res.Value)
+		}
+		if o.shared {
+			sharedCount++
+		}
+	}
+
+	if calls != 1 {
+		t.Errorf("expected fn to run exactly once, got %d calls", calls)
+	}
+	if sharedCount != 4 {
+		t.Errorf("expected 4 shared results, got %d", sharedCount)
+	}
+}
+
+func TestGroup_Do_ReportsAttempts(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int, int](0)
+
+	res, _ := g.Do(ctx, "plain", func(ctx context.Context, n int) (int, error) {
+		return n, nil
+	}, 1)
+	if res.Attempts != 1 {
+		t.Errorf("expected Attempts to be 1 for a non-retried call, got %d", res.Attempts)
+	}
+}
+
+func TestGroup_ForgetRemovesInFlightEntry(t *testing.T) {
+	ctx := context.Background()
+	g := NewGroup[string, int, int](time.Hour)
+
+	blockCh := make(chan struct{})
+	fn := func(ctx context.Context, n int) (int, error) {
+		<-blockCh
+		return n, nil
+	}
+	go g.Do(ctx, "k", fn, 1)
+	time.Sleep(10 * time.Millisecond) // let the goroutine register the in-flight entry
+
+	g.Forget("k")
+
+	var calls int32
+	fn2 := func(ctx context.Context, n int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		return n, nil
+	}
+	res, shared := g.Do(ctx, "k", fn2, 5)
+	close(blockCh)
+
+	if shared {
+		t.Error("expected fresh call after Forget to not be shared")
+	}
+	if res.Value != 5 {
+		t.Errorf("expected 5, got %v", res.Value)
+	}
+	if calls != 1 {
+		t.Errorf("expected fn2 to run once, got %d", calls)
+	}
+}
@@ -0,0 +1,158 @@
+package async
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"runtime/debug"
+	"time"
+)
+
+// RetryPolicy configures how a Fetcher retries a failing attempt. Backoff
+// between attempts grows as InitialBackoff * Multiplier^(attempt-1),
+// capped at MaxBackoff, with full jitter applied when Jitter is set.
+type RetryPolicy struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	Jitter         bool
+
+	// Retryable decides whether an attempt's error should be retried. A
+	// nil Retryable retries every error.
+	Retryable func(error) bool
+
+	// RetryOnPanic makes a panic inside Fn count as a failed attempt to
+	// be retried like any other error, instead of the default behavior
+	// of propagating it as a PanicError.
+	RetryOnPanic bool
+}
+
+// runWithRetry drives the attempt loop for a Fetcher that has a
+// RetryPolicy and/or a per-attempt timeout attached. It is only called
+// once ctx has been confirmed not yet done. When only WithTimeout was
+// used (no WithRetry), it falls back to a single-attempt policy so the
+// timeout still applies to that one attempt.
+func (f *Fetcher[T, A]) runWithRetry(ctx context.Context) Result[T] {
+	policy := f.retry
+	if policy == nil {
+		policy = &RetryPolicy{MaxAttempts: 1}
+	}
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var zero T
+	var lastErr error
+
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		attemptCtx := ctx
+		var cancel context.CancelFunc
+		if f.timeout > 0 {
+			attemptCtx, cancel = context.WithTimeout(ctx, f.timeout)
+		}
+
+		// Recorded before the call so a panic propagating out of Fn still
+		// leaves the attempt count in f.result.
+		f.result.Attempts = attempt
+		val, err, panicked := f.invokeAttempt(attemptCtx, policy.RetryOnPanic)
+		if err == nil && !panicked && attemptCtx.Err() != nil {
+			err = attemptCtx.Err()
+		}
+		if cancel != nil {
+			cancel()
+		}
+
+		if err == nil && !panicked {
+			return Result[T]{Value: val, Attempts: attempt}
+		}
+
+		if panicked {
+			handlePanicErr(ctx, err.(*PanicError))
+		}
+		lastErr = err
+
+		if ctx.Err() != nil {
+			return Result[T]{Value: zero, Err: ctx.Err(), Attempts: attempt}
+		}
+		if attempt == maxAttempts {
+			return Result[T]{Value: zero, Err: lastErr, Attempts: attempt}
+		}
+		if policy.Retryable != nil && !policy.Retryable(err) {
+			return Result[T]{Value: zero, Err: lastErr, Attempts: attempt}
+		}
+
+		if !f.sleepBackoff(ctx, policy, attempt) {
+			return Result[T]{Value: zero, Err: ctx.Err(), Attempts: attempt}
+		}
+	}
+
+	// Unreachable: every loop iteration returns explicitly above.
+	return Result[T]{Value: zero, Err: lastErr, Attempts: maxAttempts}
+}
+
+// invokeAttempt runs a single attempt of Fn. When retryOnPanic is set, a
+// panic is recovered here and reported as a (zero value, *PanicError, true)
+// attempt instead of propagating, so the retry loop can treat it like any
+// other failed attempt.
+func (f *Fetcher[T, A]) invokeAttempt(ctx context.Context, retryOnPanic bool) (val T, err error, panicked bool) {
+	if !retryOnPanic {
+		val, err = f.Fn(ctx, f.Arg)
+		return
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			panicked = true
+			err = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+	val, err = f.Fn(ctx, f.Arg)
+	return
+}
+
+// sleepBackoff waits out the backoff for the given attempt, returning
+// false if ctx is canceled first.
+func (f *Fetcher[T, A]) sleepBackoff(ctx context.Context, policy *RetryPolicy, attempt int) bool {
+	d := backoffDuration(policy, attempt)
+	if d <= 0 {
+		return ctx.Err() == nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// backoffDuration computes the exponential backoff for attempt (1-indexed),
+// capped at policy.MaxBackoff and, when policy.Jitter is set, applying
+// full jitter (a uniform random duration between 0 and the computed cap).
+func backoffDuration(policy *RetryPolicy, attempt int) time.Duration {
+	if policy.InitialBackoff <= 0 {
+		return 0
+	}
+
+	mult := policy.Multiplier
+	if mult <= 0 {
+		mult = 2
+	}
+
+	d := float64(policy.InitialBackoff) * math.Pow(mult, float64(attempt-1))
+	if policy.MaxBackoff > 0 && d > float64(policy.MaxBackoff) {
+		d = float64(policy.MaxBackoff)
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	if !policy.Jitter {
+		return time.Duration(d)
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
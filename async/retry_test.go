@@ -0,0 +1,233 @@
+package async
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFetcher_Retry_SucceedsAfterFailures(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		c := atomic.AddInt32(&calls, 1)
+		if c < 3 {
+			return 0, errors.New("transient")
+		}
+		return n, nil
+	}
+
+	f := Async(fn, 42).WithRetry(RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+	}).Run(ctx)
+
+	val, err := f.Await()
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if val != 42 {
+		t.Errorf("expected 42, got %v", val)
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+}
+
+func TestFetcher_Retry_ResultAttemptsMatchesCallCount(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		c := atomic.AddInt32(&calls, 1)
+		if c < 3 {
+			return 0, errors.New("transient")
+		}
+		return n, nil
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+	}).Run(ctx)
+
+	res := f.Result()
+	if res.Attempts != 3 {
+		t.Errorf("expected Result.Attempts to be 3, got %d", res.Attempts)
+	}
+}
+
+func TestFetcher_Retry_ExhaustsMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	permanent := errors.New("permanent")
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		return 0, permanent
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}).Run(ctx)
+
+	_, err := f.Await()
+	if !errors.Is(err, permanent) {
+		t.Fatalf("expected permanent error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("expected exactly 3 attempts, got %d", calls)
+	}
+}
+
+func TestFetcher_Retry_StopsWhenNotRetryable(t *testing.T) {
+	ctx := context.Background()
+	fatal := errors.New("fatal")
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		return 0, fatal
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    5,
+		InitialBackoff: time.Millisecond,
+		Retryable:      func(err error) bool { return !errors.Is(err, fatal) },
+	}).Run(ctx)
+
+	res := f.Result()
+	if !errors.Is(res.Err, fatal) {
+		t.Fatalf("expected fatal error, got %v", res.Err)
+	}
+	if calls != 1 {
+		t.Errorf("expected retry loop to stop after the first non-retryable attempt, got %d calls", calls)
+	}
+	if res.Attempts != 1 {
+		t.Errorf("expected Result.Attempts to reflect the single attempt made, got %d", res.Attempts)
+	}
+}
+
+func TestFetcher_WithTimeout_PerAttempt(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	f := Async(fn, 1).
+		WithTimeout(10 * time.Millisecond).
+		WithRetry(RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond}).
+		Run(ctx)
+
+	_, err := f.Await()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestFetcher_WithTimeout_AppliesWithoutRetry(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}
+
+	f := Async(fn, 1).WithTimeout(10 * time.Millisecond).Run(ctx)
+
+	res := f.Result()
+	if !errors.Is(res.Err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", res.Err)
+	}
+	if calls != 1 {
+		t.Errorf("expected exactly 1 attempt, got %d", calls)
+	}
+	if res.Attempts != 1 {
+		t.Errorf("expected Result.Attempts to be 1, got %d", res.Attempts)
+	}
+}
+
+func TestFetcher_Retry_PanicRetriedWhenRetryOnPanicSet(t *testing.T) {
+	ctx := context.Background()
+	var calls int32
+	fn := func(ctx context.Context, n int) (int, error) {
+		c := atomic.AddInt32(&calls, 1)
+		if c < 2 {
+			panic("transient panic")
+		}
+		return n, nil
+	}
+
+	f := Async(fn, 7).WithRetry(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		RetryOnPanic:   true,
+	}).Run(ctx)
+
+	val, err := f.Await()
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if val != 7 {
+		t.Errorf("expected 7, got %v", val)
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 attempts, got %d", calls)
+	}
+}
+
+func TestFetcher_Retry_PanicOnExhaustionCallsPanicHandlers(t *testing.T) {
+	ctx := context.Background()
+	handlerCalls := 0
+	SetPanicHandlers(func(c context.Context, pe *PanicError) {
+		handlerCalls++
+	})
+	defer SetPanicHandlers()
+
+	fn := func(ctx context.Context, n int) (int, error) {
+		panic("always panics")
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+		RetryOnPanic:   true,
+	}).Run(ctx)
+
+	res := f.Result()
+	var pe *PanicError
+	if !errors.As(res.Err, &pe) {
+		t.Fatalf("expected a *PanicError, got %T", res.Err)
+	}
+	if handlerCalls != 3 {
+		t.Errorf("expected the panic handler to be called once per attempt (3), got %d", handlerCalls)
+	}
+}
+
+func TestFetcher_Retry_PanicPropagatesWithoutRetryOnPanic(t *testing.T) {
+	ctx := context.Background()
+	fn := func(ctx context.Context, n int) (int, error) {
+		panic("boom")
+	}
+
+	f := Async(fn, 1).WithRetry(RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: time.Millisecond,
+	}).Run(ctx)
+
+	_, err := f.Await()
+	var pe *PanicError
+	if !errors.As(err, &pe) {
+		t.Fatalf("expected a *PanicError, got %T", err)
+	}
+	if pe.Value != "boom" {
+		t.Errorf("expected recovered value 'boom', got %v", pe.Value)
+	}
+}